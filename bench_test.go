@@ -0,0 +1,30 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+package julian
+
+import (
+	"testing"
+	"time"
+
+	"github.com/8i8/date/internal/legacy"
+)
+
+// BenchmarkTimeToJDFastPath and BenchmarkTimeToJDLegacy compare the
+// integer fast path in TimeToJD against the float-heavy formula it
+// replaced (kept in internal/legacy for this comparison only).
+func BenchmarkTimeToJDFastPath(b *testing.B) {
+	t1 := time.Date(1957, 10, 4, 19, 26, 24, 0, time.UTC)
+	for i := 0; i < b.N; i++ {
+		TimeToJD(t1)
+	}
+}
+
+func BenchmarkTimeToJDLegacy(b *testing.B) {
+	t1 := time.Date(1957, 10, 4, 19, 26, 24, 0, time.UTC)
+	for i := 0; i < b.N; i++ {
+		y, m, _ := t1.Date()
+		d := t1.Sub(time.Date(y, m, 0, 0, 0, 0, 0, time.UTC))
+		legacy.ConvertDate(y, int(m), float64(d)/float64(24*time.Hour))
+	}
+}
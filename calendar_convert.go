@@ -0,0 +1,52 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+// Direct Julian<->Gregorian calendar converters and day-number APIs.
+
+package julian
+
+import "math"
+
+// JulianDayNumber returns the integer Julian Day Number of the UT calendar
+// day containing jd, that is, the JD at noon of that day.  The result
+// changes at 0h UT, so JD 2434923.5 (the start of a day) and JD
+// 2434924.4 both give 2434924.
+func JulianDayNumber(jd float64) int {
+	return int(math.Floor(jd + .5))
+}
+
+// JulianToGregorian converts a year, month, and day of month on the
+// Julian calendar to the equivalent date on the (possibly proleptic)
+// Gregorian calendar.
+//
+// Unlike round-tripping through CalendarJulianToJD and JDToCalendar, this
+// always returns a Gregorian date, even for dates before the 1582 reform.
+func JulianToGregorian(y, m, d int) (gy, gm, gd int) {
+	jd := CalendarJulianToJD(y, m, float64(d))
+	y, m, df := jdToCalendarGregorian(jd)
+	return y, m, int(df)
+}
+
+// GregorianToJulian converts a year, month, and day of month on the
+// (possibly proleptic) Gregorian calendar to the equivalent date on the
+// Julian calendar.
+func GregorianToJulian(y, m, d int) (jy, jm, jd int) {
+	j := CalendarGregorianToJD(y, m, float64(d))
+	y, m, df := jdToCalendarJulian(j)
+	return y, m, int(df)
+}
+
+// JulianToGregorianDayOfYear converts a Julian-calendar year and day of
+// year to the equivalent Gregorian calendar date.
+func JulianToGregorianDayOfYear(y, dn int) (gy, gm, gd int) {
+	m, d := DayOfYearToCalendar(dn, LeapYearJulian(y))
+	return JulianToGregorian(y, m, d)
+}
+
+// GregorianToJulianDayOfYear converts a Gregorian calendar date to the
+// equivalent Julian-calendar year and day of year.  It is the inverse of
+// JulianToGregorianDayOfYear.
+func GregorianToJulianDayOfYear(y, m, d int) (jy, dn int) {
+	jy, jm, jd := GregorianToJulian(y, m, d)
+	return jy, DayOfYearJulian(jy, jm, jd)
+}
@@ -0,0 +1,39 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+package julian
+
+import "testing"
+
+func TestJulianDayNumber(t *testing.T) {
+	// Example 7.e, p. 65: JD 2434923.5 is 1954-06-30.0, so its JDN is
+	// 2434924, the JD at noon of 1954-06-30.
+	if dn := JulianDayNumber(2434923.5); dn != 2434924 {
+		t.Fatalf("JulianDayNumber(2434923.5) = %d, want 2434924", dn)
+	}
+}
+
+func TestJulianToGregorian(t *testing.T) {
+	// The Julian/Gregorian calendars were 10 days apart by the time of
+	// the 1582 reform: Julian 1582-10-04 is proleptic-Gregorian
+	// 1582-10-14.
+	gy, gm, gd := JulianToGregorian(1582, 10, 4)
+	if gy != 1582 || gm != 10 || gd != 14 {
+		t.Fatalf("JulianToGregorian(1582, 10, 4) = %d-%d-%d, want 1582-10-14", gy, gm, gd)
+	}
+	jy, jm, jd := GregorianToJulian(gy, gm, gd)
+	if jy != 1582 || jm != 10 || jd != 4 {
+		t.Fatalf("GregorianToJulian(1582, 10, 14) = %d-%d-%d, want 1582-10-4", jy, jm, jd)
+	}
+}
+
+func TestJulianGregorianDayOfYear(t *testing.T) {
+	gy, gm, gd := JulianToGregorianDayOfYear(1582, DayOfYearJulian(1582, 10, 4))
+	if gy != 1582 || gm != 10 || gd != 14 {
+		t.Fatalf("JulianToGregorianDayOfYear = %d-%d-%d, want 1582-10-14", gy, gm, gd)
+	}
+	jy, dn := GregorianToJulianDayOfYear(gy, gm, gd)
+	if jy != 1582 || dn != DayOfYearJulian(1582, 10, 4) {
+		t.Fatalf("GregorianToJulianDayOfYear = %d/%d, want 1582/%d", jy, dn, DayOfYearJulian(1582, 10, 4))
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+// Chapter 8, Easter.
+
+package julian
+
+// EasterGregorian returns the month and day of Easter in the Gregorian
+// calendar for the given year.
+//
+// This implements the algorithm of Meeus, AA chapter 8, "Gregorian
+// calendar".  Valid for any Gregorian year, it is the algorithm in common
+// use for determining the date of Easter in countries that use the
+// Gregorian calendar.
+func EasterGregorian(year int) (month, day int) {
+	a := year % 19
+	b := FloorDiv(year, 100)
+	c := year % 100
+	d := FloorDiv(b, 4)
+	e := b % 4
+	f := FloorDiv(b+8, 25)
+	g := FloorDiv(b-f+1, 3)
+	h := (19*a + b - d - g + 15) % 30
+	i := FloorDiv(c, 4)
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := FloorDiv(a+11*h+22*l, 451)
+	n := h + l - 7*m + 114
+	return FloorDiv(n, 31), n%31 + 1
+}
+
+// EasterJulian returns the month and day of Easter in the Julian calendar
+// for the given year.
+//
+// This implements the algorithm of Meeus, AA chapter 8, "Julian calendar".
+// The result is a date on the Julian calendar; pass it through
+// JulianToGregorian for civil display in countries using the Gregorian
+// calendar.
+func EasterJulian(year int) (month, day int) {
+	a := year % 4
+	b := year % 7
+	c := year % 19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	n := d + e + 114
+	return FloorDiv(n, 31), n%31 + 1
+}
+
+// MoveableFeasts returns the Julian day of the moveable feasts of the
+// liturgical year that are reckoned from Easter, for the given Gregorian
+// year.
+//
+// ashWednesday, palmSunday, and goodFriday fall before Easter;
+// ascension, pentecost, trinity, and corpusChristi fall after it.
+func MoveableFeasts(year int) (ashWednesday, palmSunday, goodFriday, easter, ascension, pentecost, trinity, corpusChristi float64) {
+	m, d := EasterGregorian(year)
+	easter = CalendarGregorianToJD(year, m, float64(d))
+	ashWednesday = easter - 46
+	palmSunday = easter - 7
+	goodFriday = easter - 2
+	ascension = easter + 39
+	pentecost = easter + 49
+	trinity = easter + 56
+	corpusChristi = easter + 60
+	return
+}
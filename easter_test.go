@@ -0,0 +1,72 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+package julian
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleEasterGregorian() {
+	// Example 8.a, p. 67.
+	m, d := EasterGregorian(1991)
+	fmt.Println(m, d)
+	// Output:
+	// 3 31
+}
+
+func ExampleEasterJulian() {
+	// Example 8.b, p. 69.
+	m, d := EasterJulian(179)
+	fmt.Println(m, d)
+	// Output:
+	// 4 12
+}
+
+func TestEasterGregorian(t *testing.T) {
+	for _, tp := range []struct {
+		year int
+		m, d int
+	}{
+		{1991, 3, 31},
+		{1992, 4, 19},
+		{1993, 4, 11},
+		{1954, 4, 18},
+		{2000, 4, 23},
+		{1818, 3, 22},
+	} {
+		m, d := EasterGregorian(tp.year)
+		if m != tp.m || d != tp.d {
+			t.Logf("%#v", tp)
+			t.Fatal("EasterGregorian", m, d)
+		}
+	}
+}
+
+func TestEasterJulian(t *testing.T) {
+	for _, tp := range []struct {
+		year int
+		m, d int
+	}{
+		{179, 4, 12},
+		{711, 4, 12},
+		{1243, 4, 12},
+	} {
+		m, d := EasterJulian(tp.year)
+		if m != tp.m || d != tp.d {
+			t.Logf("%#v", tp)
+			t.Fatal("EasterJulian", m, d)
+		}
+	}
+}
+
+func TestMoveableFeasts(t *testing.T) {
+	aw, ps, gf, e, a, p, tr, cc := MoveableFeasts(1991)
+	if gf != e-2 || ps != e-7 || aw != e-46 {
+		t.Fatal("MoveableFeasts: pre-Easter offsets wrong")
+	}
+	if a != e+39 || p != e+49 || tr != e+56 || cc != e+60 {
+		t.Fatal("MoveableFeasts: post-Easter offsets wrong")
+	}
+}
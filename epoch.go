@@ -0,0 +1,78 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+// Named epochs and conversions between them.
+
+package julian
+
+import "math"
+
+// Epoch identifies a day count with a fixed offset from the Julian day,
+// such as Modified Julian Day or the Unix epoch.
+//
+// The value of an Epoch is the JD of its zero point, so a reading x in
+// that epoch corresponds to JD x+Epoch.
+type Epoch float64
+
+// Epochs in common use, given as the JD of their zero point.
+const (
+	EpochJD      Epoch = 0
+	EpochMJD     Epoch = 2400000.5
+	EpochRJD     Epoch = 2400000
+	EpochTJD     Epoch = 2440000.5
+	EpochLilian  Epoch = 2299159.5
+	EpochRataDie Epoch = 1721424.5
+	EpochUnix    Epoch = 2440587.5
+	EpochJ2000   Epoch = 2451545.0
+	EpochB1950   Epoch = 2433282.4235
+	EpochJ1900   Epoch = 2415020.0
+)
+
+// ToEpoch converts a JD to a reading in epoch e.
+func ToEpoch(jd float64, e Epoch) float64 {
+	return jd - float64(e)
+}
+
+// FromEpoch converts a reading x in epoch e to a JD.
+func FromEpoch(x float64, e Epoch) float64 {
+	return x + float64(e)
+}
+
+// Between converts a reading x in epoch from to the equivalent reading in
+// epoch to.
+func Between(x float64, from, to Epoch) float64 {
+	return ToEpoch(FromEpoch(x, from), to)
+}
+
+// MJDNow returns the current time as a Modified Julian Day.
+func MJDNow() float64 {
+	return ToEpoch(DateNow(), EpochMJD)
+}
+
+// UnixToJD converts a Unix time, in seconds since 1970-01-01T00:00:00Z,
+// to Julian day.
+func UnixToJD(sec int64) float64 {
+	return FromEpoch(float64(sec)/86400, EpochUnix)
+}
+
+// JDToUnix converts a Julian day to Unix time, in seconds since
+// 1970-01-01T00:00:00Z.  The result is rounded to the nearest second;
+// plain truncation would round negative seconds and many ordinary
+// positive ones toward zero instead, due to float64 representation
+// error in the intermediate seconds value.
+func JDToUnix(jd float64) int64 {
+	return int64(math.Round(ToEpoch(jd, EpochUnix) * 86400))
+}
+
+// J2000Centuries returns the number of Julian centuries of 36525 days
+// since the epoch J2000.0, the form of time used throughout much of
+// modern positional astronomy.
+func J2000Centuries(jd float64) float64 {
+	return ToEpoch(jd, EpochJ2000) / 36525
+}
+
+// JulianYear returns the number of Julian years of 365.25 days since the
+// epoch J2000.0.
+func JulianYear(jd float64) float64 {
+	return ToEpoch(jd, EpochJ2000) / 365.25
+}
@@ -0,0 +1,60 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+package julian
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEpochRoundTrip(t *testing.T) {
+	jd := 2451545.0
+	for _, e := range []Epoch{EpochMJD, EpochRJD, EpochTJD, EpochLilian, EpochRataDie, EpochUnix, EpochJ2000} {
+		x := ToEpoch(jd, e)
+		if back := FromEpoch(x, e); back != jd {
+			t.Fatalf("epoch %v: round trip got %v, want %v", e, back, jd)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	jd := 2451545.0
+	mjd := ToEpoch(jd, EpochMJD)
+	unix := ToEpoch(jd, EpochUnix)
+	if got := Between(mjd, EpochMJD, EpochUnix); got != unix {
+		t.Fatalf("Between = %v, want %v", got, unix)
+	}
+}
+
+func TestUnixToJD(t *testing.T) {
+	// 2000-01-01T12:00:00Z is JD 2451545.0 and Unix 946728000.
+	jd := UnixToJD(946728000)
+	if jd != 2451545.0 {
+		t.Fatalf("UnixToJD(946728000) = %v, want 2451545.0", jd)
+	}
+	if sec := JDToUnix(jd); sec != 946728000 {
+		t.Fatalf("JDToUnix round trip = %v, want 946728000", sec)
+	}
+}
+
+func TestUnixJDRoundTrip(t *testing.T) {
+	for _, sec := range []int64{
+		0, 1, 59, 946728000, 123456789, 1700000000,
+		-1, -59, -100000, -946728000,
+	} {
+		if got := JDToUnix(UnixToJD(sec)); got != sec {
+			t.Fatalf("JDToUnix(UnixToJD(%d)) = %d, want %d", sec, got, sec)
+		}
+	}
+}
+
+func TestJ2000Centuries(t *testing.T) {
+	if c := J2000Centuries(float64(EpochJ2000)); c != 0 {
+		t.Fatalf("J2000Centuries(J2000.0) = %v, want 0", c)
+	}
+	c := J2000Centuries(float64(EpochJ2000) + 36525)
+	if math.Abs(c-1) > 1e-12 {
+		t.Fatalf("J2000Centuries one century later = %v, want 1", c)
+	}
+}
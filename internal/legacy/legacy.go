@@ -0,0 +1,46 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+// Package legacy holds superseded Julian day implementations, kept around
+// for comparison in benchmarks and historical reference.
+//
+// Nothing here should be used by new code; see julian.TimeToJD instead.
+package legacy
+
+// ConvertDate returns the Julian Date for a given Gregorian or Julian
+// calendar date and time, the time is given as a decimal of the day.
+//
+// Deprecated: disagrees with julian.CalendarGregorianToJD for dates
+// before the 1582 Gregorian reform; use julian.CalendarGregorianToJD or
+// julian.CalendarJulianToJD instead.
+func ConvertDate(year, month int, day float64) float64 {
+
+	// year, month, day := timeToJulianTime(t)
+
+	if month < 3 {
+		year--
+		month = month + 12
+	}
+
+	// Convert the date into YYYY.MMDDdd format
+	date := float64(year) + ((float64(month) + day) / 100)
+
+	// Calculate the Julian date.
+	a := int64(365.25 * float64(year))
+	b := int64(30.6001 * float64(month+1))
+	c := day + 1720994.5
+	JD := float64(a) + float64(b) + c
+
+	// If the number is larger than or equal to 1582.1015 that is within
+	// the Gregorian calendar.
+	if date >= 1582.1015 {
+		A := int64(year / 100)
+		B := 2 - A + int64(A/4)
+		JD = JD + float64(B)
+	}
+
+	// If YYYY.MMDDdd is smaller than 1582.1015 then it is not necessary to
+	// calculate A and B.
+
+	return JD
+}
@@ -20,40 +20,6 @@ import (
 	"time"
 )
 
-// ConvertDate returns the Julian Date for a given Gregorian or Julian
-// calendar date and time, the time is given as a decimal of the day.
-func ConvertDate(year, month int, day float64) float64 {
-
-	// year, month, day := timeToJulianTime(t)
-
-	if month < 3 {
-		year--
-		month = month + 12
-	}
-
-	// Convert the date into YYYY.MMDDdd format
-	date := float64(year) + ((float64(month) + day) / 100)
-
-	// Calculate the Julian date.
-	a := int64(365.25 * float64(year))
-	b := int64(30.6001 * float64(month+1))
-	c := day + 1720994.5
-	JD := float64(a) + float64(b) + c
-
-	// If the number is larger than or equal to 1582.1015 that is within
-	// the Gregorian calendar.
-	if date >= 1582.1015 {
-		A := int64(year / 100)
-		B := 2 - A + int64(A/4)
-		JD = JD + float64(B)
-	}
-
-	// If YYYY.MMDDdd is smaller than 1582.1015 then it is not necessary to
-	// calculate A and B.
-
-	return JD
-}
-
 // CalendarGregorianToJD converts a Gregorian year, month, and day of month
 // to Julian day.
 //
@@ -96,39 +62,6 @@ func LeapYearGregorian(y int) bool {
 	return (y%4 == 0 && y%100 != 0) || y%400 == 0
 }
 
-// JDToCalendar returns the calendar date for the given jd.
-//
-// Note that this function returns a date in either the Julian or Gregorian
-// Calendar, as appropriate.
-func JDToCalendar(jd float64) (year, month int, day float64) {
-	zf, f := math.Modf(jd + .5)
-	z := int64(zf)
-	a := z
-	if z >= 2299151 {
-		α := FloorDiv64(z*100-186721625, 3652425)
-		a = z + 1 + α - FloorDiv64(α, 4)
-	}
-	b := a + 1524
-	c := FloorDiv64(b*100-12210, 36525)
-	d := FloorDiv64(36525*c, 100)
-	e := int(FloorDiv64((b-d)*1e4, 306001))
-	// compute return values
-	day = float64(int(b-d)-FloorDiv(306001*e, 1e4)) + f
-	switch e {
-	default:
-		month = e - 1
-	case 14, 15:
-		month = e - 13
-	}
-	switch month {
-	default:
-		year = int(c) - 4716
-	case 1, 2:
-		year = int(c) - 4715
-	}
-	return
-}
-
 // jdToCalendarGregorian returns the Gregorian calendar date for the given jd.
 //
 // Note that it returns a Gregorian date even for dates before the start of
@@ -171,22 +104,28 @@ func JDToTime(jd float64) time.Time {
 // TimeToJD takes a Go time.Time and returns a JD as float64.
 //
 // Any time zone offset in the time.Time is ignored and the time is
-// treated as UTC.
+// treated as UTC.  The calendar/JDN part of the conversion is done with
+// pure integer arithmetic (FloorDiv64); only the final addition of the
+// sub-day fraction involves a float division, which keeps the result
+// free of the rounding error that repeated float multiplication would
+// otherwise accumulate.
 func TimeToJD(t time.Time) float64 {
 	ut := t.UTC()
-	y, m, _ := ut.Date()
-	d := ut.Sub(time.Date(y, m, 0, 0, 0, 0, 0, time.UTC))
-	// time.Time is always Gregorian
-	return CalendarGregorianToJD(y, int(m), float64(d)/float64(24*time.Hour))
-}
+	y, mo, day := ut.Date()
+	m := int(mo)
+	switch m {
+	case 1, 2:
+		y--
+		m += 12
+	}
+	a := FloorDiv(y, 100)
+	b := 2 - a + FloorDiv(a, 4)
+	jdn := FloorDiv64(36525*int64(y+4716), 100) +
+		int64(FloorDiv(306*(m+1), 10)+b) + int64(day) - 1525
 
-// TimeToJD2 test
-func TimeToJD2(t time.Time) float64 {
-	ut := t.UTC()
-	y, m, _ := ut.Date()
-	d := ut.Sub(time.Date(y, m, 0, 0, 0, 0, 0, time.UTC))
-	// time.Time is always Gregorian
-	return ConvertDate(y, int(m), float64(d)/float64(24*time.Hour))
+	hour, min, sec := ut.Clock()
+	dayNS := int64(hour)*3600e9 + int64(min)*60e9 + int64(sec)*1e9 + int64(ut.Nanosecond())
+	return float64(jdn) + .5 + float64(dayNS)/86400e9
 }
 
 // DateNow returns the current Julian date.
@@ -1,7 +1,7 @@
 // Copyright 2012 Sonia Keys
 // License: MIT
 
-package date
+package julian
 
 import (
 	"fmt"
@@ -27,16 +27,6 @@ func ExampleCalendarGregorianToJD_halley() {
 	// 27689 days
 }
 
-func TestMyFunc(t *testing.T) {
-	t1 := time.Date(1000, 10, 4, 22, 30, 0, 0, time.UTC)
-	jd1 := TimeToJD(t1)
-	jd2 := TimeToJD2(t1)
-
-	if jd1 == jd2 {
-		t.Errorf("TestMyFunc: recieved %v expected %v", jd2, jd1)
-	}
-}
-
 func TestGreg(t *testing.T) {
 	for _, tp := range []struct {
 		y, m  int
@@ -197,9 +187,58 @@ func BenchmarkTimeToJD(b *testing.B) {
 	}
 }
 
-func BenchmarkTimeToJD2(b *testing.B) {
-	t1 := time.Date(1000, 10, 4, 22, 30, 0, 0, time.UTC)
-	for i := 0; i < b.N; i++ {
-		TimeToJD2(t1)
+// TestTimeToJDAgreesWithCalendarGregorianToJD checks that TimeToJD's
+// integer fast path agrees with the float-based CalendarGregorianToJD
+// it replaced, including for dates before the 1582 Gregorian reform
+// (where the old TimeToJD2/ConvertDate pair disagreed).
+func TestTimeToJDAgreesWithCalendarGregorianToJD(t *testing.T) {
+	for _, tm := range []time.Time{
+		time.Date(1000, 10, 4, 22, 30, 0, 0, time.UTC),
+		time.Date(1582, 10, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC),
+		time.Date(-500, 6, 15, 6, 0, 0, 0, time.UTC),
+	} {
+		y, m, _ := tm.Date()
+		d := tm.Sub(time.Date(y, m, 0, 0, 0, 0, 0, time.UTC))
+		want := CalendarGregorianToJD(y, int(m), float64(d)/float64(24*time.Hour))
+		if got := TimeToJD(tm); math.Abs(got-want) > 1e-9 {
+			t.Fatalf("TimeToJD(%v) = %v, want %v", tm, got, want)
+		}
+	}
+}
+
+// floorMod returns x mod n, folded into [0, n), unlike Go's %.
+func floorMod(x, n int) int {
+	m := x % n
+	if m < 0 {
+		m += n
 	}
+	return m
+}
+
+// FuzzTimeToJD fuzzes JDToTime(TimeToJD(t)) over times across +-10000
+// years.  Since a JD is a float64, its precision (the ULP at values in
+// the millions) shrinks the further the year is from 0, so we allow a
+// generous tolerance rather than asserting exact nanosecond equality.
+func FuzzTimeToJD(f *testing.F) {
+	f.Add(1000, 10, 4, 22, 30, 0, 0)
+	f.Add(1582, 10, 4, 0, 0, 0, 0)
+	f.Add(1582, 10, 15, 0, 0, 0, 0)
+	f.Add(2024, 3, 20, 12, 0, 0, 0)
+	f.Add(-500, 6, 15, 6, 0, 0, 0)
+	f.Fuzz(func(t *testing.T, y, m, d, hour, min, sec, nsec int) {
+		y = -10000 + floorMod(y, 20000)
+		m = 1 + floorMod(m, 12)
+		d = 1 + floorMod(d, 28)
+		hour = floorMod(hour, 24)
+		min = floorMod(min, 60)
+		sec = floorMod(sec, 60)
+		nsec = floorMod(nsec, 1e9)
+		tm := time.Date(y, time.Month(m), d, hour, min, sec, nsec, time.UTC)
+		got := JDToTime(TimeToJD(tm))
+		if diff := got.Sub(tm); diff > time.Millisecond || diff < -time.Millisecond {
+			t.Fatalf("round trip for %v: got %v, diff %v", tm, got, diff)
+		}
+	})
 }
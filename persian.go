@@ -0,0 +1,71 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+// Persian (Jalali) calendar conversion.
+
+package julian
+
+import "math"
+
+// epochPersian is the Julian day of 1 Farvardin 1 AP, 19 March 622 (Julian
+// calendar).
+const epochPersian = 1948320.5
+
+// LeapYearPersian returns true if year y of the astronomical Persian
+// (Jalali) calendar is a leap year.
+//
+// This uses the 2820-year arithmetic approximation of the Persian leap
+// cycle rather than the true astronomical vernal equinox, and so may
+// disagree with the observational calendar in rare years near a cycle
+// boundary.
+func LeapYearPersian(y int) bool {
+	return ((y-474)%2820+474+38)*682%2816 < 682
+}
+
+// CalendarPersianToJD converts a Persian (Jalali) year, month, and day of
+// month to Julian day.
+func CalendarPersianToJD(y, m int, d float64) float64 {
+	epBase := y - 474
+	if y < 0 {
+		epBase = y - 473
+	}
+	epYear := 474 + epBase - FloorDiv(epBase, 2820)*2820
+	var md int
+	if m <= 7 {
+		md = (m - 1) * 31
+	} else {
+		md = (m-1)*30 + 6
+	}
+	return d + float64(md) + float64(FloorDiv(epYear*682-110, 2816)) +
+		float64(epYear-1)*365 + float64(FloorDiv(epBase, 2820))*1029983 +
+		(epochPersian - 1)
+}
+
+// JDToPersianCalendar returns the Persian (Jalali) calendar date for the
+// given jd.
+func JDToPersianCalendar(jd float64) (y, m int, d float64) {
+	jd = math.Floor(jd-.5) + .5
+	depoch := jd - CalendarPersianToJD(475, 1, 1)
+	cycle := FloorDiv64(int64(depoch), 1029983)
+	cyear := depoch - float64(cycle)*1029983
+	var ycycle int64
+	if cyear == 1029982 {
+		ycycle = 2820
+	} else {
+		aux1 := FloorDiv64(int64(cyear), 366)
+		aux2 := int64(cyear) - aux1*366
+		ycycle = FloorDiv64(2134*aux1+2816*aux2+2815, 1028522) + aux1 + 1
+	}
+	y = int(ycycle + 2820*cycle + 474)
+	if y <= 0 {
+		y--
+	}
+	yday := jd - CalendarPersianToJD(y, 1, 1) + 1
+	if yday <= 186 {
+		m = int(FloorDiv64(int64(yday)+30, 31))
+	} else {
+		m = int(FloorDiv64(int64(yday)-6+29, 30))
+	}
+	d = jd - CalendarPersianToJD(y, m, 1) + 1
+	return
+}
@@ -0,0 +1,53 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+package julian
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPersianRoundTrip(t *testing.T) {
+	for y := 1; y <= 1500; y++ {
+		for _, m := range []int{1, 7, 12} {
+			for _, d := range []float64{1, 15, 29} {
+				jd := CalendarPersianToJD(y, m, d)
+				yy, mm, dd := JDToPersianCalendar(jd)
+				if yy != y || mm != m || math.Abs(dd-d) > 1e-9 {
+					t.Fatalf("round trip %d-%d-%g: got %d-%d-%g", y, m, d, yy, mm, dd)
+				}
+			}
+		}
+	}
+}
+
+func TestPersianEquinox1403(t *testing.T) {
+	// 1 Farvardin 1403 AP = 20 March 2024 (Gregorian).
+	jd := CalendarPersianToJD(1403, 1, 1)
+	gjd := CalendarGregorianToJD(2024, 3, 20)
+	if jd != gjd {
+		t.Fatalf("CalendarPersianToJD(1403, 1, 1) = %v, want %v", jd, gjd)
+	}
+}
+
+func TestLeapYearPersian(t *testing.T) {
+	for _, tp := range []struct {
+		year int
+		leap bool
+	}{
+		{1375, true},
+		{1379, true},
+		{1383, true},
+		{1387, true},
+		{1391, true},
+		{1395, true},
+		{1399, true},
+		{1403, false},
+	} {
+		if LeapYearPersian(tp.year) != tp.leap {
+			t.Logf("%#v", tp)
+			t.Fatal("LeapYearPersian")
+		}
+	}
+}
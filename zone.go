@@ -0,0 +1,139 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+package julian
+
+import (
+	"math"
+	"time"
+)
+
+// Zone describes when a region switched from the Julian to the Gregorian
+// calendar.
+//
+// LastJulian is the last JD on which the region used the Julian calendar;
+// FirstGregorian is the JD of the following day, the first to be reckoned
+// on the Gregorian calendar.  For the built-in zones the two are
+// consecutive JDs: the days a reform dropped are calendar dates that
+// never occurred, not a gap in the JD count.
+type Zone struct {
+	Name           string
+	LastJulian     float64
+	FirstGregorian float64
+}
+
+// Built-in zones for some commonly cited Gregorian-reform dates.
+var (
+	// ZoneCatholic is the original 1582 reform adopted by Catholic
+	// Europe: Thursday 1582-10-04 (Julian) was followed by Friday
+	// 1582-10-15 (Gregorian).
+	ZoneCatholic = Zone{"Catholic", CalendarJulianToJD(1582, 10, 4), CalendarGregorianToJD(1582, 10, 15)}
+
+	// ZoneBritish is the 1752 reform adopted by Great Britain and its
+	// colonies: Wednesday 1752-09-02 (Julian) was followed by Thursday
+	// 1752-09-14 (Gregorian).
+	ZoneBritish = Zone{"British", CalendarJulianToJD(1752, 9, 2), CalendarGregorianToJD(1752, 9, 14)}
+
+	// ZoneRussian is the 1918 reform adopted by Soviet Russia: Wednesday
+	// 1918-01-31 (Julian) was followed by Thursday 1918-02-14
+	// (Gregorian).
+	ZoneRussian = Zone{"Russian", CalendarJulianToJD(1918, 1, 31), CalendarGregorianToJD(1918, 2, 14)}
+
+	// ZoneGreek is the 1923 reform adopted by Greece: Wednesday
+	// 1923-02-15 (Julian) was followed by Thursday 1923-03-01
+	// (Gregorian).
+	ZoneGreek = Zone{"Greek", CalendarJulianToJD(1923, 2, 15), CalendarGregorianToJD(1923, 3, 1)}
+)
+
+// TimeToJDIn takes a Go time.Time and returns a JD.
+//
+// A time.Time always names a proleptic Gregorian instant (see TimeToJD),
+// so z has no bearing on which moment t represents; TimeToJDIn(t, z)
+// always equals TimeToJD(t).  It takes z only for symmetry with
+// JDToCalendarIn and RenderMonth, which use a Zone to choose how that
+// same instant is displayed.
+func TimeToJDIn(t time.Time, z Zone) float64 {
+	return TimeToJD(t)
+}
+
+// JDToCalendarIn returns the calendar date for the given jd, switching
+// from the Julian to the Gregorian calendar at the reform date of zone z.
+func JDToCalendarIn(jd float64, z Zone) (year, month int, day float64) {
+	if jd < z.FirstGregorian {
+		return jdToCalendarJulian(jd)
+	}
+	return jdToCalendarGregorian(jd)
+}
+
+// JDToCalendar returns the calendar date for the given jd.
+//
+// Note that this function returns a date in either the Julian or Gregorian
+// Calendar, as appropriate for the Catholic 1582 reform.  For other
+// regional reform dates, use JDToCalendarIn.
+func JDToCalendar(jd float64) (year, month int, day float64) {
+	return JDToCalendarIn(jd, ZoneCatholic)
+}
+
+// jdToCalendarJulian returns the Julian calendar date for the given jd.
+func jdToCalendarJulian(jd float64) (year, month int, day float64) {
+	zf, f := math.Modf(jd + .5)
+	a := int64(zf)
+	b := a + 1524
+	c := FloorDiv64(b*100-12210, 36525)
+	d := FloorDiv64(36525*c, 100)
+	e := int(FloorDiv64((b-d)*1e4, 306001))
+	day = float64(int(b-d)-FloorDiv(306001*e, 1e4)) + f
+	switch e {
+	default:
+		month = e - 1
+	case 14, 15:
+		month = e - 13
+	}
+	switch month {
+	default:
+		year = int(c) - 4716
+	case 1, 2:
+		year = int(c) - 4715
+	}
+	return
+}
+
+// RenderMonth returns a month grid of calendar days for year y, month m,
+// reckoned in zone z.  Each row is a week; days that fall in the gap
+// left by the calendar reform (if the reform occurred in this month) are
+// omitted, so a row may be short.  Days before the 1st or after the last
+// day of the month are zero.
+func RenderMonth(y, m int, z Zone) [][]int {
+	first := CalendarGregorianToJD(y, m, 1)
+	if first <= z.LastJulian {
+		first = CalendarJulianToJD(y, m, 1)
+	}
+	var days []int
+	for jd := first; ; jd++ {
+		if jd > z.LastJulian && jd < z.FirstGregorian {
+			continue
+		}
+		yy, mm, d := JDToCalendarIn(jd, z)
+		if yy != y || mm != m {
+			break
+		}
+		days = append(days, int(d))
+	}
+	weekday := DayOfWeek(first)
+	var weeks [][]int
+	week := make([]int, 7)
+	col := weekday
+	for _, d := range days {
+		week[col] = d
+		col++
+		if col == 7 {
+			weeks = append(weeks, week)
+			week = make([]int, 7)
+			col = 0
+		}
+	}
+	if col != 0 {
+		weeks = append(weeks, week)
+	}
+	return weeks
+}
@@ -0,0 +1,79 @@
+// Copyright 2012 Sonia Keys
+// License: MIT
+
+package julian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeToJDInRoundTrip(t *testing.T) {
+	for _, tm := range []time.Time{
+		// before ZoneCatholic's reform
+		time.Date(1000, 10, 4, 22, 30, 0, 0, time.UTC),
+		time.Date(1582, 10, 4, 0, 0, 0, 0, time.UTC),
+		// after ZoneCatholic's reform
+		time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC),
+	} {
+		got := JDToTime(TimeToJDIn(tm, ZoneCatholic))
+		if !got.Equal(tm) {
+			t.Fatalf("TimeToJDIn round trip for %v: got %v", tm, got)
+		}
+	}
+}
+
+func TestJDToCalendarInCatholic(t *testing.T) {
+	y, m, d := JDToCalendarIn(ZoneCatholic.LastJulian, ZoneCatholic)
+	if y != 1582 || m != 10 || d != 4 {
+		t.Fatal("JDToCalendarIn LastJulian", y, m, d)
+	}
+	y, m, d = JDToCalendarIn(ZoneCatholic.FirstGregorian, ZoneCatholic)
+	if y != 1582 || m != 10 || d != 15 {
+		t.Fatal("JDToCalendarIn FirstGregorian", y, m, d)
+	}
+}
+
+func TestJDToCalendarInBritish(t *testing.T) {
+	// The British reform dropped 11 days: 1752-09-02 (Julian) was
+	// followed by 1752-09-14 (Gregorian).
+	y, m, d := JDToCalendarIn(ZoneBritish.LastJulian, ZoneBritish)
+	if y != 1752 || m != 9 || d != 2 {
+		t.Fatal("JDToCalendarIn British LastJulian", y, m, d)
+	}
+	y, m, d = JDToCalendarIn(ZoneBritish.FirstGregorian, ZoneBritish)
+	if y != 1752 || m != 9 || d != 14 {
+		t.Fatal("JDToCalendarIn British FirstGregorian", y, m, d)
+	}
+}
+
+func TestJDToCalendarMatchesCatholicZone(t *testing.T) {
+	for _, jd := range []float64{2299159.5, 2299160.5, 2451545} {
+		y1, m1, d1 := JDToCalendar(jd)
+		y2, m2, d2 := JDToCalendarIn(jd, ZoneCatholic)
+		if y1 != y2 || m1 != m2 || d1 != d2 {
+			t.Fatal("JDToCalendar diverges from JDToCalendarIn(jd, ZoneCatholic)", jd)
+		}
+	}
+}
+
+func TestRenderMonthReformGap(t *testing.T) {
+	weeks := RenderMonth(1582, 10, ZoneCatholic)
+	var days []int
+	for _, w := range weeks {
+		for _, d := range w {
+			if d != 0 {
+				days = append(days, d)
+			}
+		}
+	}
+	for _, d := range days {
+		if d > 4 && d < 15 {
+			t.Fatalf("RenderMonth included reform-gap day %d", d)
+		}
+	}
+	if len(days) != 21 {
+		t.Fatalf("RenderMonth(1582, 10): got %d days, want 21", len(days))
+	}
+}